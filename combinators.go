@@ -0,0 +1,125 @@
+package requiring
+
+import (
+	"errors"
+	"fmt"
+)
+
+// And runs every v against the value, joining all violations. It is mostly
+// sugar for passing multiple validators to RuleSet.Add at once, useful when
+// building up a Validator outside of a RuleSet (e.g. for Or or When).
+func And(vs ...Validator) Validator {
+	return &andValidator{vs: vs}
+}
+
+type andValidator struct {
+	vs []Validator
+}
+
+// Validate treats v as its own root, for use outside a RuleSet. Attached
+// via RuleSet.Add, ValidateRoot is used instead so a nested When still sees
+// the struct's true root rather than this field's value.
+func (a *andValidator) Validate(v any) error {
+	return a.ValidateRoot(v, v)
+}
+
+func (a *andValidator) ValidateRoot(root, v any) error {
+	var errs []error
+	for _, p := range a.vs {
+		if err := dispatchValidate(root, v, p); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Or passes if any v passes. If every v fails, it joins all of their
+// violations, since none alone explains the failure.
+func Or(vs ...Validator) Validator {
+	return &orValidator{vs: vs}
+}
+
+type orValidator struct {
+	vs []Validator
+}
+
+func (o *orValidator) Validate(v any) error {
+	return o.ValidateRoot(v, v)
+}
+
+func (o *orValidator) ValidateRoot(root, v any) error {
+	var errs []error
+	for _, p := range o.vs {
+		err := dispatchValidate(root, v, p)
+		if err == nil {
+			return nil
+		}
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// Not passes only if v fails; v's own violation carries no meaning under
+// negation, so Not reports its own NotViolationError instead.
+func Not(v Validator) Validator {
+	return &notValidator{v: v}
+}
+
+type notValidator struct {
+	v Validator
+}
+
+func (n *notValidator) Validate(v any) error {
+	return n.ValidateRoot(v, v)
+}
+
+func (n *notValidator) ValidateRoot(root, v any) error {
+	if err := dispatchValidate(root, v, n.v); err == nil {
+		return &NotViolationError{Value: v}
+	}
+	return nil
+}
+
+type NotViolationError struct {
+	Value any
+}
+
+func (e *NotViolationError) Error() string {
+	return fmt.Sprintf("must not satisfy the wrapped rule, got %v", e.Value)
+}
+
+func (e *NotViolationError) Code() string {
+	return "not"
+}
+
+func (e *NotViolationError) Params() map[string]any {
+	return map[string]any{"value": e.Value}
+}
+
+// When applies v only if pred reports true. pred receives the struct
+// passed to RuleSet.Validate, letting cross-field rules such as "if
+// Country==US then ZIP required" be expressed without every field needing
+// its own bespoke Validator. Outside of a RuleSet, pred instead receives
+// the value being validated.
+func When(pred func(root any) bool, v Validator) Validator {
+	return &whenValidator{pred: pred, v: v}
+}
+
+type whenValidator struct {
+	pred func(root any) bool
+	v    Validator
+}
+
+func (w *whenValidator) Validate(v any) error {
+	if !w.pred(v) {
+		return nil
+	}
+	return w.v.Validate(v)
+}
+
+func (w *whenValidator) ValidateRoot(root, v any) error {
+	if !w.pred(root) {
+		return nil
+	}
+	return dispatchValidate(root, v, w.v)
+}
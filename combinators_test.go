@@ -0,0 +1,86 @@
+package requiring
+
+import (
+	"testing"
+
+	"github.com/lufia/go-requiring/validator"
+)
+
+func TestAnd(t *testing.T) {
+	v := And(NotEmpty, validator.MinLength[string](3))
+	if err := v.Validate("abc"); err != nil {
+		t.Errorf("Validate(%q) = %v, want nil", "abc", err)
+	}
+	if err := v.Validate("ab"); err == nil {
+		t.Error("Validate(\"ab\") = nil, want a violation")
+	}
+}
+
+func TestOr(t *testing.T) {
+	v := Or(validator.MinLength[string](3), validator.MaxLength[string](1))
+	if err := v.Validate("a"); err != nil {
+		t.Errorf("Validate(%q) = %v, want nil (passes MaxLength1)", "a", err)
+	}
+	if err := v.Validate("abc"); err != nil {
+		t.Errorf("Validate(%q) = %v, want nil (passes MinLength3)", "abc", err)
+	}
+	if err := v.Validate("ab"); err == nil {
+		t.Error("Validate(\"ab\") = nil, want both branches to fail")
+	}
+}
+
+func TestNot(t *testing.T) {
+	v := Not(validator.MinLength[string](3))
+	if err := v.Validate("a"); err != nil {
+		t.Errorf("Validate(%q) = %v, want nil", "a", err)
+	}
+	if err := v.Validate("abc"); err == nil {
+		t.Error("Validate(\"abc\") = nil, want a NotViolationError")
+	}
+}
+
+type address struct {
+	Country string
+	Zip     string
+}
+
+// Regression test: And/Or/Not used to call each child's Validate directly,
+// so a When nested under one of them and attached via RuleSet.Add received
+// the field's own value as root instead of the struct being validated. For
+// a When predicate that type-asserts root to the struct type, that used to
+// panic instead of just evaluating the predicate against the wrong value.
+func TestRuleSet_WhenNestedInAnd(t *testing.T) {
+	s := Struct(func(s *RuleSet, v *address) {
+		s.Add(&v.Zip, "Zip", And(When(func(root any) bool {
+			return root.(address).Country == "US"
+		}, NotEmpty)))
+	})
+
+	if err := s.Validate(&address{Country: "US", Zip: ""}); err == nil {
+		t.Error("Validate() = nil, want a violation for a missing Zip when Country is US")
+	}
+	if err := s.Validate(&address{Country: "JP", Zip: ""}); err != nil {
+		t.Errorf("Validate() = %v, want nil when Country is not US", err)
+	}
+	if err := s.Validate(&address{Country: "US", Zip: "94105"}); err != nil {
+		t.Errorf("Validate() = %v, want nil for a non-empty Zip", err)
+	}
+}
+
+func TestRuleSet_WhenNestedInOr(t *testing.T) {
+	s := Struct(func(s *RuleSet, v *address) {
+		s.Add(&v.Zip, "Zip", Or(When(func(root any) bool {
+			return root.(address).Country == "US"
+		}, validator.MinLength[string](5)), validator.MinLength[string](10)))
+	})
+
+	if err := s.Validate(&address{Country: "US", Zip: "94105"}); err != nil {
+		t.Errorf("Validate() = %v, want nil", err)
+	}
+	if err := s.Validate(&address{Country: "US", Zip: "941"}); err == nil {
+		t.Error("Validate() = nil, want a violation: Country==US requires a 5-char Zip via the nested When")
+	}
+	if err := s.Validate(&address{Country: "JP", Zip: "100"}); err != nil {
+		t.Errorf("Validate() = %v, want nil", err)
+	}
+}
@@ -0,0 +1,486 @@
+package requiring
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/lufia/go-requiring/validator"
+)
+
+// ruleToken is the parsed form of a single directive out of a rule
+// expression, e.g. "min_len=1" or "@string[1,10]".
+type ruleToken struct {
+	Name       string
+	Args       []string
+	Range      *[2]int
+	Pattern    *regexp.Regexp
+	Default    string
+	HasDefault bool
+	Optional   bool
+	IsType     bool // true for an "@name" type shorthand
+}
+
+// TypeHandler builds validators for the "@name[...]" and "@name/.../" type
+// shorthands. Built-in types are registered in typeHandlers; third parties
+// extend it with RegisterType.
+type TypeHandler struct {
+	Range   func(min, max int) Validator
+	Pattern func(re *regexp.Regexp) Validator
+}
+
+var typeHandlers = map[string]*TypeHandler{
+	"string": {
+		Range: func(min, max int) Validator { return validator.Length[string](min, max) },
+	},
+}
+
+// RegisterType installs a type shorthand usable as "@name[min,max]" or
+// "@name/regex/" in a rule expression.
+func RegisterType(name string, h *TypeHandler) {
+	typeHandlers[name] = h
+}
+
+// ruleRegistry dispatches the bare and "name=value" directives of a rule
+// expression, e.g. "required" or "min_len=1". RegisterRule lets third
+// parties add their own names.
+var ruleRegistry = map[string]func(args []string, typ reflect.Type) (Validator, error){}
+
+// RegisterRule installs a named rule usable in a ParseRule expression or a
+// `validate:"..."` struct tag. fn receives the tagged field's reflect.Type
+// when compiled via StructFromTag, or nil when resolved through a
+// standalone ParseRule expression with no field to check against; a fn
+// that only applies to certain kinds should use checkKind to reject the
+// rest with a build-time error instead of panicking out of Validate.
+func RegisterRule(name string, fn func(args []string, typ reflect.Type) (Validator, error)) {
+	ruleRegistry[name] = fn
+}
+
+// checkKind reports an error if typ is known and isn't of kind, turning a
+// tag/field type mismatch (e.g. "required" on an int field) into a
+// build-time error instead of a reflect panic the first time Validate
+// runs. typ is nil when resolving a standalone ParseRule expression, which
+// has no field to check against.
+func checkKind(typ reflect.Type, kind reflect.Kind, rule string) error {
+	if typ == nil || typ.Kind() == kind {
+		return nil
+	}
+	return fmt.Errorf("requiring: rule %q does not apply to field of type %s", rule, typ)
+}
+
+func init() {
+	RegisterRule("required", func(args []string, typ reflect.Type) (Validator, error) {
+		if err := checkKind(typ, reflect.String, "required"); err != nil {
+			return nil, err
+		}
+		return NotEmpty, nil
+	})
+	RegisterRule("min_len", func(args []string, typ reflect.Type) (Validator, error) {
+		if err := checkKind(typ, reflect.String, "min_len"); err != nil {
+			return nil, err
+		}
+		n, err := ruleIntArg(args, "min_len")
+		if err != nil {
+			return nil, err
+		}
+		return validator.MinLength[string](n), nil
+	})
+	RegisterRule("max_len", func(args []string, typ reflect.Type) (Validator, error) {
+		if err := checkKind(typ, reflect.String, "max_len"); err != nil {
+			return nil, err
+		}
+		n, err := ruleIntArg(args, "max_len")
+		if err != nil {
+			return nil, err
+		}
+		return validator.MaxLength[string](n), nil
+	})
+	RegisterRule("pattern", func(args []string, typ reflect.Type) (Validator, error) {
+		if err := checkKind(typ, reflect.String, "pattern"); err != nil {
+			return nil, err
+		}
+		if len(args) != 1 {
+			return nil, fmt.Errorf("requiring: rule %q takes exactly one argument", "pattern")
+		}
+		re, err := regexp.Compile(args[0])
+		if err != nil {
+			return nil, fmt.Errorf("requiring: rule %q: %w", "pattern", err)
+		}
+		return validator.Pattern[string](re), nil
+	})
+
+	typeHandlers["string"].Pattern = func(re *regexp.Regexp) Validator { return validator.Pattern[string](re) }
+}
+
+func ruleIntArg(args []string, name string) (int, error) {
+	if len(args) != 1 {
+		return 0, fmt.Errorf("requiring: rule %q takes exactly one argument", name)
+	}
+	n, err := strconv.Atoi(args[0])
+	if err != nil {
+		return 0, fmt.Errorf("requiring: rule %q: %w", name, err)
+	}
+	return n, nil
+}
+
+// ParseRule compiles a rule expression into a Validator chaining the typed
+// validators it names. An expression is a comma-separated list of
+// directives:
+//
+//	@string[1,10]                    a Length validator via the "string" type
+//	@string/\d+/                     a Pattern validator via the "string" type
+//	@name?                           marks the whole expression optional
+//	@name = "default"                applies a default when the field is zero (StructFromTag only)
+//	required,min_len=1,max_len=64    chained bare/"name=value" directives
+//
+// Directives are dispatched through a registry (see RegisterRule and
+// RegisterType), so rule names beyond the built-ins above can be added by
+// third parties.
+func ParseRule(dsl string) (Validator, error) {
+	toks, err := tokenizeRule(dsl)
+	if err != nil {
+		return nil, err
+	}
+	v, _, _, err := buildRuleChain(toks, nil)
+	return v, err
+}
+
+// buildRuleChain resolves toks into a Validator. typ is the tagged
+// field's type when called from StructFromTag, used to reject a rule that
+// doesn't apply to the field's kind at build time instead of panicking the
+// first time Validate runs; it is nil for a standalone ParseRule
+// expression. It also returns the default value recorded by an
+// "@name = \"default\"" token, if any, for the caller to apply.
+func buildRuleChain(toks []ruleToken, typ reflect.Type) (Validator, string, bool, error) {
+	var vs []Validator
+	var def string
+	var hasDefault, optional bool
+	for _, t := range toks {
+		if t.Optional || t.HasDefault {
+			optional = true
+		}
+		if t.HasDefault {
+			def, hasDefault = t.Default, true
+		}
+		v, err := t.resolve(typ)
+		if err != nil {
+			return nil, "", false, err
+		}
+		if v != nil {
+			vs = append(vs, v)
+		}
+	}
+	var v Validator = &ruleChain{Validators: vs}
+	if optional {
+		v = &optionalValidator{v: v}
+	}
+	return v, def, hasDefault, nil
+}
+
+func (t ruleToken) resolve(typ reflect.Type) (Validator, error) {
+	switch {
+	case t.Range != nil:
+		h, ok := typeHandlers[t.Name]
+		if !ok || h.Range == nil {
+			return nil, fmt.Errorf("requiring: type %q does not support a range", t.Name)
+		}
+		return h.Range(t.Range[0], t.Range[1]), nil
+	case t.Pattern != nil:
+		name := t.Name
+		if name == "" {
+			name = "string"
+			if err := checkKind(typ, reflect.String, "pattern"); err != nil {
+				return nil, err
+			}
+		}
+		h, ok := typeHandlers[name]
+		if !ok || h.Pattern == nil {
+			return nil, fmt.Errorf("requiring: type %q does not support a pattern", name)
+		}
+		return h.Pattern(t.Pattern), nil
+	case t.HasDefault:
+		return nil, nil
+	case t.IsType:
+		return nil, nil
+	case t.Name == "optional":
+		return nil, nil
+	default:
+		fn, ok := ruleRegistry[t.Name]
+		if !ok {
+			return nil, fmt.Errorf("requiring: unknown rule %q", t.Name)
+		}
+		return fn(t.Args, typ)
+	}
+}
+
+// ruleChain runs every Validator in order and joins their violations,
+// mirroring the join behaviour of rule.Validate.
+type ruleChain struct {
+	Validators []Validator
+}
+
+func (c *ruleChain) Validate(v any) error {
+	var errs []error
+	for _, p := range c.Validators {
+		if err := p.Validate(v); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// optionalValidator skips the wrapped Validator when the value is the zero
+// value for its type.
+type optionalValidator struct {
+	v Validator
+}
+
+func (o *optionalValidator) Validate(v any) error {
+	if rv := reflect.ValueOf(v); !rv.IsValid() || rv.IsZero() {
+		return nil
+	}
+	return o.v.Validate(v)
+}
+
+func tokenizeRule(dsl string) ([]ruleToken, error) {
+	parts := splitRuleTokens(dsl)
+	toks := make([]ruleToken, 0, len(parts))
+	for _, p := range parts {
+		t, err := parseRuleToken(p)
+		if err != nil {
+			return nil, err
+		}
+		toks = append(toks, t)
+	}
+	return toks, nil
+}
+
+// splitRuleTokens splits a rule expression on top-level commas, ignoring
+// commas inside a "[...]" range or a "/.../" pattern.
+func splitRuleTokens(s string) []string {
+	var parts []string
+	depth := 0
+	inPattern := false
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch {
+		case inPattern:
+			if s[i] == '/' && (i == 0 || s[i-1] != '\\') {
+				inPattern = false
+			}
+		case s[i] == '/':
+			inPattern = true
+		case s[i] == '[':
+			depth++
+		case s[i] == ']':
+			depth--
+		case s[i] == ',' && depth == 0:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	parts = append(parts, s[start:])
+	return parts
+}
+
+func parseRuleToken(raw string) (ruleToken, error) {
+	s := strings.TrimSpace(raw)
+	if s == "" {
+		return ruleToken{}, fmt.Errorf("requiring: empty rule directive in %q", raw)
+	}
+	if strings.HasPrefix(s, "@") {
+		return parseTypeToken(s[1:], raw)
+	}
+	return parseFlagToken(s, raw)
+}
+
+func parseTypeToken(s, raw string) (ruleToken, error) {
+	name, rest := splitRuleName(s)
+	t := ruleToken{Name: name, IsType: true}
+	rest = strings.TrimSpace(rest)
+	switch {
+	case rest == "":
+	case rest[0] == '[':
+		end := strings.IndexByte(rest, ']')
+		if end < 0 || end != len(rest)-1 {
+			return t, fmt.Errorf("requiring: unterminated range in %q", raw)
+		}
+		min, max, err := parseRuleRange(rest[1:end])
+		if err != nil {
+			return t, fmt.Errorf("requiring: %q: %w", raw, err)
+		}
+		t.Range = &[2]int{min, max}
+	case rest[0] == '/':
+		end := indexUnescaped(rest[1:], '/')
+		if end < 0 || 1+end != len(rest)-1 {
+			return t, fmt.Errorf("requiring: unterminated pattern in %q", raw)
+		}
+		re, err := regexp.Compile(rest[1 : 1+end])
+		if err != nil {
+			return t, fmt.Errorf("requiring: invalid pattern in %q: %w", raw, err)
+		}
+		t.Pattern = re
+	case rest[0] == '?':
+		t.Optional = true
+	case rest[0] == '=':
+		t.Default = strings.Trim(strings.TrimSpace(rest[1:]), `"`)
+		t.HasDefault = true
+	default:
+		return t, fmt.Errorf("requiring: unexpected %q after @%s", rest, name)
+	}
+	return t, nil
+}
+
+func parseFlagToken(s, raw string) (ruleToken, error) {
+	name, value, hasValue := strings.Cut(s, "=")
+	name = strings.TrimSpace(name)
+	t := ruleToken{Name: name}
+	if !hasValue {
+		if name == "optional" {
+			t.Optional = true
+		}
+		return t, nil
+	}
+	value = strings.TrimSpace(value)
+	if name == "pattern" && strings.HasPrefix(value, "/") {
+		end := indexUnescaped(value[1:], '/')
+		if end < 0 || 1+end != len(value)-1 {
+			return t, fmt.Errorf("requiring: unterminated pattern in %q", raw)
+		}
+		re, err := regexp.Compile(value[1 : 1+end])
+		if err != nil {
+			return t, fmt.Errorf("requiring: invalid pattern in %q: %w", raw, err)
+		}
+		// Clear Name so resolve's t.Pattern branch defaults it to "string",
+		// the same as the slash-less @string/.../  type shorthand, instead
+		// of looking up a nonexistent typeHandlers["pattern"].
+		t.Name = ""
+		t.Pattern = re
+		return t, nil
+	}
+	t.Args = []string{value}
+	return t, nil
+}
+
+func splitRuleName(s string) (name, rest string) {
+	i := 0
+	for i < len(s) && isRuleNameByte(s[i]) {
+		i++
+	}
+	return s[:i], s[i:]
+}
+
+func isRuleNameByte(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+func indexUnescaped(s string, c byte) int {
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' {
+			i++
+			continue
+		}
+		if s[i] == c {
+			return i
+		}
+	}
+	return -1
+}
+
+func parseRuleRange(s string) (min, max int, err error) {
+	lo, hi, ok := strings.Cut(s, ",")
+	if !ok {
+		return 0, 0, fmt.Errorf("range must be \"min,max\", got %q", s)
+	}
+	min, err = strconv.Atoi(strings.TrimSpace(lo))
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid range min %q: %w", lo, err)
+	}
+	max, err = strconv.Atoi(strings.TrimSpace(hi))
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid range max %q: %w", hi, err)
+	}
+	return min, max, nil
+}
+
+// applyDefault sets f to the parsed form of raw if f currently holds its
+// zero value, so a field tagged "@type = \"default\"" validates against
+// the default instead of being silently skipped the way "@type?" is.
+func applyDefault(f reflect.Value, raw string) error {
+	if !f.IsZero() {
+		return nil
+	}
+	switch f.Kind() {
+	case reflect.String:
+		f.SetString(raw)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return fmt.Errorf("requiring: invalid default %q: %w", raw, err)
+		}
+		f.SetInt(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return fmt.Errorf("requiring: invalid default %q: %w", raw, err)
+		}
+		f.SetFloat(n)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return fmt.Errorf("requiring: invalid default %q: %w", raw, err)
+		}
+		f.SetBool(b)
+	default:
+		return fmt.Errorf("requiring: default values are not supported for type %s", f.Type())
+	}
+	return nil
+}
+
+// tagRuleSets caches the RuleSet compiled from a struct type's `validate`
+// tags, so repeated calls to StructFromTag for the same T only pay the
+// reflection and parsing cost once.
+var tagRuleSets sync.Map // reflect.Type -> Validator
+
+// StructFromTag builds a Validator for T by reading each field's
+// `validate:"..."` struct tag through ParseRule, complementing the
+// programmatic Struct API. The compiled result is cached per type.
+func StructFromTag[T any]() (Validator, error) {
+	var v T
+	typ := reflect.TypeOf(v)
+	if cached, ok := tagRuleSets.Load(typ); ok {
+		return cached.(Validator), nil
+	}
+	s := &RuleSet{base: &v}
+	for _, f := range reflect.VisibleFields(typ) {
+		tag, ok := f.Tag.Lookup("validate")
+		if !ok || tag == "-" {
+			continue
+		}
+		toks, err := tokenizeRule(tag)
+		if err != nil {
+			return nil, fmt.Errorf("requiring: field %s: %w", f.Name, err)
+		}
+		rv, def, hasDefault, err := buildRuleChain(toks, f.Type)
+		if err != nil {
+			return nil, fmt.Errorf("requiring: field %s: %w", f.Name, err)
+		}
+		if s.rules == nil {
+			s.rules = make(map[string]*rule)
+		}
+		s.rules[f.Name] = &rule{
+			Name:       f.Name,
+			Validators: []Validator{rv},
+			Offset:     f.Offset,
+			Index:      f.Index,
+			Default:    def,
+			HasDefault: hasDefault,
+		}
+	}
+	tagRuleSets.Store(typ, Validator(s))
+	return s, nil
+}
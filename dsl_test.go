@@ -0,0 +1,155 @@
+package requiring
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/lufia/go-requiring/validator"
+)
+
+func TestParseRule_Chain(t *testing.T) {
+	v, err := ParseRule("required,min_len=1,max_len=4")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := v.Validate("ab"); err != nil {
+		t.Errorf("Validate(%q) = %v, want nil", "ab", err)
+	}
+	if err := v.Validate(""); err == nil {
+		t.Error("Validate(\"\") = nil, want an error")
+	}
+	if err := v.Validate("abcde"); err == nil {
+		t.Error("Validate(\"abcde\") = nil, want an error")
+	}
+}
+
+func TestParseRule_TypeShorthand(t *testing.T) {
+	v, err := ParseRule("@string[1,4]")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := v.Validate("ab"); err != nil {
+		t.Errorf("Validate(%q) = %v, want nil", "ab", err)
+	}
+	if err := v.Validate(""); err == nil {
+		t.Error("Validate(\"\") = nil, want an error")
+	}
+}
+
+func TestParseRule_TypePattern(t *testing.T) {
+	v, err := ParseRule(`@string/^[a-z]+$/`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := v.Validate("ok"); err != nil {
+		t.Errorf("Validate(%q) = %v, want nil", "ok", err)
+	}
+	if err := v.Validate("NO"); err == nil {
+		t.Error("Validate(\"NO\") = nil, want an error")
+	}
+}
+
+// Regression test: the flag-form "pattern=/.../" directive used to leave
+// t.Name == "pattern" on the token, so resolve looked up the nonexistent
+// typeHandlers["pattern"] instead of defaulting to "string" the way the
+// slash-less form and the "@string/.../" shorthand both do.
+func TestParseRule_FlagPattern(t *testing.T) {
+	v, err := ParseRule("required,min_len=1,max_len=64,pattern=/^[a-z]+$/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := v.Validate("abc"); err != nil {
+		t.Errorf("Validate(%q) = %v, want nil", "abc", err)
+	}
+	if err := v.Validate("ABC"); err == nil {
+		t.Error("Validate(\"ABC\") = nil, want an error")
+	}
+}
+
+// Regression test: parseTypeToken's "[...]"/"/.../" cases used to accept
+// the first closing delimiter they found and silently discard anything
+// after it, so a missing comma between two directives (e.g.
+// "@string[1,10]required" instead of "@string[1,10],required") would
+// parse without error and quietly drop the trailing rule.
+func TestParseRule_TypeShorthand_RejectsTrailingGarbage(t *testing.T) {
+	if _, err := ParseRule("@string[1,10]xyz"); err == nil {
+		t.Error(`ParseRule("@string[1,10]xyz") = nil error, want an error for the trailing "xyz"`)
+	}
+	if _, err := ParseRule(`@string/^[a-z]+$/TRAILING`); err == nil {
+		t.Error(`ParseRule("@string/^[a-z]+$/TRAILING") = nil error, want an error for the trailing text`)
+	}
+}
+
+func TestParseRule_Optional(t *testing.T) {
+	v, err := ParseRule("@string?,min_len=3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := v.Validate(""); err != nil {
+		t.Errorf("Validate(\"\") = %v, want nil (optional skips the zero value)", err)
+	}
+	if err := v.Validate("ab"); err == nil {
+		t.Error("Validate(\"ab\") = nil, want an error")
+	}
+}
+
+func TestParseRule_UnknownRule(t *testing.T) {
+	_, err := ParseRule("not_a_real_rule")
+	if err == nil {
+		t.Fatal("expected an error for an unknown rule name")
+	}
+}
+
+type taggedRequired struct {
+	Age int `validate:"required"`
+}
+
+type taggedMin struct {
+	Price float64 `validate:"min=1"`
+}
+
+// Regression test: StructFromTag used to build a validator.*[string] or
+// validator.*[int] validator without checking the tagged field's actual
+// type, so Validate panicked on the type assertion instead of the
+// mismatch surfacing as a normal error from StructFromTag.
+func TestStructFromTag_RejectsTypeMismatch(t *testing.T) {
+	if _, err := StructFromTag[taggedRequired](); err == nil {
+		t.Error("StructFromTag[taggedRequired]() = nil error, want a type-mismatch error for \"required\" on an int field")
+	} else if !strings.Contains(err.Error(), "required") {
+		t.Errorf("error %q does not mention the offending rule", err)
+	}
+	if _, err := StructFromTag[taggedMin](); err == nil {
+		t.Error("StructFromTag[taggedMin]() = nil error, want a type-mismatch error for \"min\" on a float64 field")
+	}
+}
+
+type taggedDefault struct {
+	Name string `validate:"@string = \"anon\""`
+}
+
+func TestStructFromTag_AppliesDefault(t *testing.T) {
+	v, err := StructFromTag[taggedDefault]()
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := taggedDefault{}
+	if err := v.Validate(&s); err != nil {
+		t.Fatal(err)
+	}
+	if s.Name != "anon" {
+		t.Errorf("Name = %q, want the default %q to have been applied", s.Name, "anon")
+	}
+}
+
+func TestRegisterType_ExportedHandler(t *testing.T) {
+	RegisterType("zzz_test_type", &TypeHandler{
+		Range: func(min, max int) Validator { return validator.Range[int](min, max) },
+	})
+	v, err := ParseRule("@zzz_test_type[1,10]")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := v.Validate(5); err != nil {
+		t.Errorf("Validate(5) = %v, want nil", err)
+	}
+}
@@ -0,0 +1,83 @@
+package i18n
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/text/language"
+
+	"github.com/lufia/go-requiring"
+	"github.com/lufia/go-requiring/validator"
+)
+
+type person struct {
+	Name string
+	Age  int
+	Tags []string
+}
+
+// newRuleSet builds a fresh RuleSet covering one instantiation of every
+// built-in validator type localize knows how to translate, so a single
+// WithLanguage call below exercises the whole type-switch at once: if a
+// case is ever dropped (e.g. after a validator type is renamed), the
+// affected field's message silently reverts to the English default and
+// this test catches it instead of the default falling through unnoticed.
+func newRuleSet() *requiring.RuleSet {
+	v := requiring.Struct(func(s *requiring.RuleSet, v *person) {
+		s.Add(&v.Name, "Name", validator.MinLength[string](3))
+		s.Add(&v.Age, "Age", validator.Min[int](18))
+		s.Add(&v.Tags, "Tags", validator.MinItems[[]string, string](1))
+	})
+	return v.(*requiring.RuleSet)
+}
+
+func TestWithLanguage_Japanese(t *testing.T) {
+	s := WithLanguage(newRuleSet(), language.Japanese)
+	err := s.Validate(&person{Name: "a", Age: 1, Tags: nil})
+	if err == nil {
+		t.Fatal("expected violations for Name, Age, and Tags")
+	}
+	msg := err.Error()
+	for _, want := range []string{"以上である", "以上である", "要素数は"} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("error %q does not contain the expected Japanese fragment %q", msg, want)
+		}
+	}
+}
+
+func TestWithLanguage_English(t *testing.T) {
+	s := WithLanguage(newRuleSet(), language.English)
+	err := s.Validate(&person{Name: "a", Age: 1, Tags: nil})
+	if err == nil {
+		t.Fatal("expected violations for Name, Age, and Tags")
+	}
+	msg := err.Error()
+	for _, want := range []string{"length must be no less than 3", "must be no less than 18", "no fewer than 1 items"} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("error %q does not contain the expected English fragment %q", msg, want)
+		}
+	}
+}
+
+func TestWithLanguage_UnrecognizedValidatorUnchanged(t *testing.T) {
+	rv := requiring.Struct(func(s *requiring.RuleSet, v *person) {
+		s.Add(&v.Name, "Name", requiring.NotEmpty)
+	})
+	s := rv.(*requiring.RuleSet)
+	WithLanguage(s, language.Japanese)
+	err := s.Validate(&person{Name: ""})
+	if err == nil {
+		t.Fatal("expected a violation for an empty Name")
+	}
+}
+
+func TestNewCatalogPrinter(t *testing.T) {
+	v := validator.MinLength[string](3).WithPrinter(minLengthPrinter[string](language.Japanese))
+	err := v.Validate("a")
+	if err == nil {
+		t.Fatal("expected a violation")
+	}
+	if !strings.Contains(err.Error(), "以上である") {
+		t.Errorf("error %q is not localized to Japanese", err)
+	}
+}
@@ -0,0 +1,61 @@
+package requiring
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/lufia/go-requiring/validator"
+)
+
+type zipAddress struct {
+	Zip string
+}
+
+var addressValidator = Struct(func(s *RuleSet, v *zipAddress) {
+	s.Add(&v.Zip, "Zip", validator.MinLength[string](3))
+})
+
+type personWithAddress struct {
+	Address zipAddress
+	Tags    []string
+	Meta    map[string]zipAddress
+}
+
+func TestValidatePath_NestedStruct(t *testing.T) {
+	s := Struct(func(s *RuleSet, v *personWithAddress) {
+		s.Add(&v.Address, "Address", addressValidator)
+	})
+	err := s.Validate(&personWithAddress{Address: zipAddress{Zip: "x"}})
+	if err == nil {
+		t.Fatal("expected a violation for a too-short Zip")
+	}
+	if !strings.Contains(err.Error(), "Address.Zip") {
+		t.Errorf("error %q does not mention the dotted path %q", err, "Address.Zip")
+	}
+}
+
+func TestValidatePath_EachSlice(t *testing.T) {
+	s := Struct(func(s *RuleSet, v *personWithAddress) {
+		s.Add(&v.Tags, "Tags", validator.Each[[]string, string](validator.MinLength[string](2)))
+	})
+	err := s.Validate(&personWithAddress{Tags: []string{"ok", "x"}})
+	if err == nil {
+		t.Fatal("expected a violation for the too-short second tag")
+	}
+	if !strings.Contains(err.Error(), "Tags[1]") {
+		t.Errorf("error %q does not mention the bracketed index path %q", err, "Tags[1]")
+	}
+}
+
+func TestValidatePath_EachMap(t *testing.T) {
+	s := Struct(func(s *RuleSet, v *personWithAddress) {
+		s.Add(&v.Meta, "Meta", validator.EachMap[map[string]zipAddress, string, zipAddress](addressValidator))
+	})
+	err := s.Validate(&personWithAddress{Meta: map[string]zipAddress{"admin": {Zip: "x"}}})
+	if err == nil {
+		t.Fatal("expected a violation for the too-short nested Zip")
+	}
+	if !strings.Contains(err.Error(), "Meta[admin].Zip") {
+		t.Errorf("error %q does not mention the bracketed/dotted path %q", err, "Meta[admin].Zip")
+	}
+}
@@ -0,0 +1,47 @@
+package requiring
+
+import (
+	"reflect"
+
+	"github.com/lufia/go-requiring/validator"
+)
+
+func init() {
+	// min/max/multiple_of build a validator.*[int], so a tagged field must
+	// be an actual int, not some other numeric kind (float64, int64, ...)
+	// that would panic the type assertion in Validate.
+	RegisterRule("min", func(args []string, typ reflect.Type) (Validator, error) {
+		if err := checkKind(typ, reflect.Int, "min"); err != nil {
+			return nil, err
+		}
+		n, err := ruleIntArg(args, "min")
+		if err != nil {
+			return nil, err
+		}
+		return validator.Min[int](n), nil
+	})
+	RegisterRule("max", func(args []string, typ reflect.Type) (Validator, error) {
+		if err := checkKind(typ, reflect.Int, "max"); err != nil {
+			return nil, err
+		}
+		n, err := ruleIntArg(args, "max")
+		if err != nil {
+			return nil, err
+		}
+		return validator.Max[int](n), nil
+	})
+	RegisterRule("multiple_of", func(args []string, typ reflect.Type) (Validator, error) {
+		if err := checkKind(typ, reflect.Int, "multiple_of"); err != nil {
+			return nil, err
+		}
+		n, err := ruleIntArg(args, "multiple_of")
+		if err != nil {
+			return nil, err
+		}
+		return validator.MultipleOf[int](n), nil
+	})
+
+	RegisterType("int", &TypeHandler{
+		Range: func(min, max int) Validator { return validator.Range[int](min, max) },
+	})
+}
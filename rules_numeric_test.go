@@ -0,0 +1,50 @@
+package requiring
+
+import "testing"
+
+func TestParseRule_Numeric(t *testing.T) {
+	v, err := ParseRule("min=1,max=10,multiple_of=2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := v.Validate(4); err != nil {
+		t.Errorf("Validate(4) = %v, want nil", err)
+	}
+	if err := v.Validate(0); err == nil {
+		t.Error("Validate(0) = nil, want a min violation")
+	}
+	if err := v.Validate(11); err == nil {
+		t.Error("Validate(11) = nil, want a max violation")
+	}
+	if err := v.Validate(3); err == nil {
+		t.Error("Validate(3) = nil, want a multiple_of violation")
+	}
+}
+
+func TestParseRule_IntType(t *testing.T) {
+	v, err := ParseRule("@int[1,10]")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := v.Validate(5); err != nil {
+		t.Errorf("Validate(5) = %v, want nil", err)
+	}
+	if err := v.Validate(20); err == nil {
+		t.Error("Validate(20) = nil, want a range violation")
+	}
+}
+
+type taggedNumeric struct {
+	Price float64 `validate:"min=1"`
+	Count int64   `validate:"max=10"`
+}
+
+// Regression test: min/max/multiple_of build a validator.*[int] regardless
+// of the tagged field's actual numeric kind, so a float64 or int64 field
+// used to panic the type assertion in Validate instead of StructFromTag
+// reporting the mismatch up front.
+func TestStructFromTag_RejectsNonIntNumeric(t *testing.T) {
+	if _, err := StructFromTag[taggedNumeric](); err == nil {
+		t.Error("StructFromTag[taggedNumeric]() = nil error, want a type-mismatch error")
+	}
+}
@@ -7,6 +7,9 @@ import (
 	"fmt"
 	"io"
 	"reflect"
+	"strings"
+
+	"github.com/lufia/go-requiring/validator"
 )
 
 type Validator interface {
@@ -18,16 +21,58 @@ type rule struct {
 	Validators []Validator
 	Offset     uintptr // offset within struct, in bytes
 	Index      []int   // index sequence for reflect.Type.FieldByIndex
+	Default    string  // recorded by an "@name = \"default\"" DSL token
+	HasDefault bool
+}
+
+// pathValidator is implemented by validators that can validate rooted at a
+// path segment of their own rather than rendering one eagerly, namely a
+// nested *RuleSet built via Struct. It matches validator.PathValidator
+// structurally.
+type pathValidator interface {
+	ValidatePath(path string, v any) error
+}
+
+// rootValidator is implemented by validators that need the struct passed
+// to RuleSet.Validate as well as their own field's value, namely When. It
+// lets cross-field rules such as "if Country==US then ZIP required" be
+// expressed without every other Validator needing to know about root.
+type rootValidator interface {
+	ValidateRoot(root, v any) error
 }
 
-func (r *rule) Validate(v any) error {
+// dispatchValidate runs v against p, threading root through if p wants it
+// (a rootValidator, e.g. When) or letting it render its own path (a
+// pathValidator, e.g. a nested RuleSet), and otherwise just calling
+// Validate. Both rule.validate and the And/Or/Not combinators use this, so
+// a When nested inside one of them still sees the true root instead of its
+// wrapper's own field value.
+func dispatchValidate(root, v any, p Validator) error {
+	switch pv := p.(type) {
+	case pathValidator:
+		return pv.ValidatePath("", v)
+	case rootValidator:
+		return pv.ValidateRoot(root, v)
+	default:
+		return p.Validate(v)
+	}
+}
+
+// validate runs every validator for the rule and wraps any violations in a
+// *validator.PathFrag carrying the rule's own name, so the outermost
+// RuleSet.Validate can render the full path in one pass.
+func (r *rule) validate(root, v any) error {
 	var errs []error
 	for _, p := range r.Validators {
-		if err := p.Validate(v); err != nil {
-			errs = append(errs, fmt.Errorf("'%s' %w", r.Name, err))
+		if err := dispatchValidate(root, v, p); err != nil {
+			errs = append(errs, err)
 		}
 	}
-	return errors.Join(errs...)
+	joined := errors.Join(errs...)
+	if joined == nil {
+		return nil
+	}
+	return &validator.PathFrag{Seg: r.Name, Err: joined}
 }
 
 type RuleSet struct {
@@ -49,6 +94,39 @@ func (s *RuleSet) Add(p any, name string, vs ...Validator) {
 	}
 }
 
+// Rules returns the name of every field with a rule, in no particular
+// order. It lets helpers outside this package, such as requiring/i18n's
+// WithLanguage, enumerate and rewrite a RuleSet's validators without
+// needing access to its internals.
+func (s *RuleSet) Rules() []string {
+	names := make([]string, 0, len(s.rules))
+	for name := range s.rules {
+		names = append(names, name)
+	}
+	return names
+}
+
+// Validators returns the validators registered for name, or nil if there
+// is no such rule.
+func (s *RuleSet) Validators(name string) []Validator {
+	r, ok := s.rules[name]
+	if !ok {
+		return nil
+	}
+	return r.Validators
+}
+
+// SetValidators replaces the validators registered for name, reporting
+// whether name names an existing rule.
+func (s *RuleSet) SetValidators(name string, vs ...Validator) bool {
+	r, ok := s.rules[name]
+	if !ok {
+		return false
+	}
+	r.Validators = vs
+	return true
+}
+
 func (s *RuleSet) offsetOf(p any) uintptr {
 	bp := reflect.ValueOf(s.base).Pointer()
 	pp := reflect.ValueOf(p).Pointer()
@@ -66,19 +144,80 @@ func lookupStructField(p any, off uintptr) reflect.StructField {
 	panic("xxx")
 }
 
+// Validate checks v against every rule in s, reporting violations as a
+// joined error whose messages are prefixed with a dotted / bracketed field
+// path, e.g. 'Address.Zip' or 'Items[2].Name'.
 func (s *RuleSet) Validate(v any) error {
+	return renderPath("", s.ValidatePath("", v))
+}
+
+// ValidatePath is like Validate but returns the unrendered violation tree
+// rooted at path instead of formatted messages, so a RuleSet nested inside
+// another RuleSet (directly, or through validator.Each/EachMap) can have
+// its violations threaded into the parent's path rather than rendered on
+// their own. Most callers want Validate.
+func (s *RuleSet) ValidatePath(path string, v any) error {
 	p := reflect.ValueOf(v)
 	if p.Kind() == reflect.Pointer {
 		p = p.Elem()
 	}
+	root := p.Interface()
 	var errs []error
 	for _, rule := range s.rules {
 		f := p.FieldByIndex(rule.Index)
-		if err := rule.Validate(f.Interface()); err != nil {
+		if rule.HasDefault && f.CanSet() {
+			if err := applyDefault(f, rule.Default); err != nil {
+				errs = append(errs, &validator.PathFrag{Seg: rule.Name, Err: err})
+				continue
+			}
+		}
+		if err := rule.validate(root, f.Interface()); err != nil {
 			errs = append(errs, err)
 		}
 	}
-	return errors.Join(errs...)
+	joined := errors.Join(errs...)
+	if joined == nil || path == "" {
+		return joined
+	}
+	return &validator.PathFrag{Seg: path, Err: joined}
+}
+
+// renderPath walks a violation tree built from errors.Join,
+// *validator.PathFrag and *validator.IndexError, accumulating the path as
+// it descends, and formats each leaf as 'path' message.
+func renderPath(path string, err error) error {
+	if err == nil {
+		return nil
+	}
+	if j, ok := err.(interface{ Unwrap() []error }); ok {
+		var errs []error
+		for _, e := range j.Unwrap() {
+			if r := renderPath(path, e); r != nil {
+				errs = append(errs, r)
+			}
+		}
+		return errors.Join(errs...)
+	}
+	if pf, ok := err.(*validator.PathFrag); ok {
+		return renderPath(joinPath(path, pf.Seg), pf.Err)
+	}
+	if ie, ok := err.(*validator.IndexError); ok {
+		return renderPath(joinPath(path, fmt.Sprintf("[%d]", ie.Index)), ie.Err)
+	}
+	return &fieldError{Field: path, Err: err}
+}
+
+// joinPath appends seg to base, using "." unless seg is itself a bracketed
+// index/key, so "Tags" + "[2]" + ".Name" reads as "Tags[2].Name".
+func joinPath(base, seg string) string {
+	switch {
+	case base == "":
+		return seg
+	case strings.HasPrefix(seg, "["):
+		return base + seg
+	default:
+		return base + "." + seg
+	}
 }
 
 func Struct[T any](build func(s *RuleSet, v *T)) Validator {
@@ -112,6 +251,14 @@ func (e *RangeMinViolationError[T]) Error() string {
 	return buf.String()
 }
 
+func (e *RangeMinViolationError[T]) Code() string {
+	return "required"
+}
+
+func (e *RangeMinViolationError[T]) Params() map[string]any {
+	return map[string]any{"min": e.Min}
+}
+
 type notEmptyPrinter[T any] struct{}
 
 func (notEmptyPrinter[T]) Print(w io.Writer, e RangeMinViolationError[T]) {
@@ -0,0 +1,321 @@
+package validator
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+)
+
+func MinItems[T ~[]E, E any](n int) *MinItemsValidator[T, E] {
+	var r MinItemsValidator[T, E]
+	r.min = n
+	return &r
+}
+
+type MinItemsValidator[T ~[]E, E any] struct {
+	min int
+	p   MinItemsViolationPrinter[T, E]
+}
+
+func (r *MinItemsValidator[T, E]) WithPrinter(p MinItemsViolationPrinter[T, E]) *MinItemsValidator[T, E] {
+	rr := *r
+	rr.p = p
+	return &rr
+}
+
+func (r *MinItemsValidator[T, E]) WithPrinterFunc(fn func(w io.Writer, min int)) *MinItemsValidator[T, E] {
+	rr := *r
+	rr.p = printerFunc(func(w io.Writer, e *MinItemsViolationError[T, E]) {
+		fn(w, e.Min)
+	})
+	return &rr
+}
+
+func (r *MinItemsValidator[T, E]) Validate(v any) error {
+	a := v.(T)
+	if len(a) < r.min {
+		return &MinItemsViolationError[T, E]{
+			Value: a,
+			Min:   r.min,
+			rule:  r,
+		}
+	}
+	return nil
+}
+
+type MinItemsViolationError[T ~[]E, E any] struct {
+	Value T
+	Min   int
+	rule  *MinItemsValidator[T, E]
+}
+
+func (e MinItemsViolationError[T, E]) Error() string {
+	p := e.rule.p
+	if p == nil {
+		p = &minItemsViolationPrinter[T, E]{}
+	}
+	var w bytes.Buffer
+	p.Print(&w, &e)
+	return w.String()
+}
+
+func (e MinItemsViolationError[T, E]) Code() string {
+	return "min_items"
+}
+
+func (e MinItemsViolationError[T, E]) Params() map[string]any {
+	return map[string]any{"min": e.Min}
+}
+
+type minItemsViolationPrinter[T ~[]E, E any] struct{}
+
+func (minItemsViolationPrinter[T, E]) Print(w io.Writer, e *MinItemsViolationError[T, E]) {
+	fmt.Fprintf(w, "must contain no fewer than %v items", e.Min)
+}
+
+type MinItemsViolationPrinter[T ~[]E, E any] interface {
+	Printer[MinItemsViolationError[T, E]]
+}
+
+var _ typedValidator[
+	*MinItemsValidator[[]string, string],
+	MinItemsViolationError[[]string, string],
+	MinItemsViolationPrinter[[]string, string],
+] = (*MinItemsValidator[[]string, string])(nil)
+
+func MaxItems[T ~[]E, E any](n int) *MaxItemsValidator[T, E] {
+	var r MaxItemsValidator[T, E]
+	r.max = n
+	return &r
+}
+
+type MaxItemsValidator[T ~[]E, E any] struct {
+	max int
+	p   MaxItemsViolationPrinter[T, E]
+}
+
+func (r *MaxItemsValidator[T, E]) WithPrinter(p MaxItemsViolationPrinter[T, E]) *MaxItemsValidator[T, E] {
+	rr := *r
+	rr.p = p
+	return &rr
+}
+
+func (r *MaxItemsValidator[T, E]) WithPrinterFunc(fn func(w io.Writer, max int)) *MaxItemsValidator[T, E] {
+	rr := *r
+	rr.p = printerFunc(func(w io.Writer, e *MaxItemsViolationError[T, E]) {
+		fn(w, e.Max)
+	})
+	return &rr
+}
+
+func (r *MaxItemsValidator[T, E]) Validate(v any) error {
+	a := v.(T)
+	if len(a) > r.max {
+		return &MaxItemsViolationError[T, E]{
+			Value: a,
+			Max:   r.max,
+			rule:  r,
+		}
+	}
+	return nil
+}
+
+type MaxItemsViolationError[T ~[]E, E any] struct {
+	Value T
+	Max   int
+	rule  *MaxItemsValidator[T, E]
+}
+
+func (e MaxItemsViolationError[T, E]) Error() string {
+	p := e.rule.p
+	if p == nil {
+		p = &maxItemsViolationPrinter[T, E]{}
+	}
+	var w bytes.Buffer
+	p.Print(&w, &e)
+	return w.String()
+}
+
+func (e MaxItemsViolationError[T, E]) Code() string {
+	return "max_items"
+}
+
+func (e MaxItemsViolationError[T, E]) Params() map[string]any {
+	return map[string]any{"max": e.Max}
+}
+
+type maxItemsViolationPrinter[T ~[]E, E any] struct{}
+
+func (maxItemsViolationPrinter[T, E]) Print(w io.Writer, e *MaxItemsViolationError[T, E]) {
+	fmt.Fprintf(w, "must contain no more than %v items", e.Max)
+}
+
+type MaxItemsViolationPrinter[T ~[]E, E any] interface {
+	Printer[MaxItemsViolationError[T, E]]
+}
+
+var _ typedValidator[
+	*MaxItemsValidator[[]string, string],
+	MaxItemsViolationError[[]string, string],
+	MaxItemsViolationPrinter[[]string, string],
+] = (*MaxItemsValidator[[]string, string])(nil)
+
+// Items is a convenience combining MinItems and MaxItems, mirroring Length.
+func Items[T ~[]E, E any](min, max int) Validator {
+	return &itemsValidator[T, E]{min: MinItems[T, E](min), max: MaxItems[T, E](max)}
+}
+
+type itemsValidator[T ~[]E, E any] struct {
+	min *MinItemsValidator[T, E]
+	max *MaxItemsValidator[T, E]
+}
+
+func (r *itemsValidator[T, E]) Validate(v any) error {
+	return errors.Join(r.min.Validate(v), r.max.Validate(v))
+}
+
+func UniqueItems[T ~[]E, E comparable]() *UniqueItemsValidator[T, E] {
+	return &UniqueItemsValidator[T, E]{}
+}
+
+type UniqueItemsValidator[T ~[]E, E comparable] struct {
+	p UniqueItemsViolationPrinter[T, E]
+}
+
+func (r *UniqueItemsValidator[T, E]) WithPrinter(p UniqueItemsViolationPrinter[T, E]) *UniqueItemsValidator[T, E] {
+	rr := *r
+	rr.p = p
+	return &rr
+}
+
+func (r *UniqueItemsValidator[T, E]) WithPrinterFunc(fn func(w io.Writer, dup E, index int)) *UniqueItemsValidator[T, E] {
+	rr := *r
+	rr.p = printerFunc(func(w io.Writer, e *UniqueItemsViolationError[T, E]) {
+		fn(w, e.Duplicate, e.Index)
+	})
+	return &rr
+}
+
+func (r *UniqueItemsValidator[T, E]) Validate(v any) error {
+	a := v.(T)
+	seen := make(map[E]int, len(a))
+	for i, e := range a {
+		if j, ok := seen[e]; ok {
+			return &UniqueItemsViolationError[T, E]{
+				Value:     a,
+				Duplicate: e,
+				Index:     i,
+				First:     j,
+				rule:      r,
+			}
+		}
+		seen[e] = i
+	}
+	return nil
+}
+
+type UniqueItemsViolationError[T ~[]E, E comparable] struct {
+	Value     T
+	Duplicate E
+	Index     int // index of the duplicate
+	First     int // index it first appeared at
+	rule      *UniqueItemsValidator[T, E]
+}
+
+func (e UniqueItemsViolationError[T, E]) Error() string {
+	p := e.rule.p
+	if p == nil {
+		p = &uniqueItemsViolationPrinter[T, E]{}
+	}
+	var w bytes.Buffer
+	p.Print(&w, &e)
+	return w.String()
+}
+
+func (e UniqueItemsViolationError[T, E]) Code() string {
+	return "unique_items"
+}
+
+func (e UniqueItemsViolationError[T, E]) Params() map[string]any {
+	return map[string]any{"index": e.Index, "first": e.First}
+}
+
+type uniqueItemsViolationPrinter[T ~[]E, E comparable] struct{}
+
+func (uniqueItemsViolationPrinter[T, E]) Print(w io.Writer, e *UniqueItemsViolationError[T, E]) {
+	fmt.Fprintf(w, "must not contain duplicate items, %v at index %d duplicates index %d", e.Duplicate, e.Index, e.First)
+}
+
+type UniqueItemsViolationPrinter[T ~[]E, E comparable] interface {
+	Printer[UniqueItemsViolationError[T, E]]
+}
+
+var _ typedValidator[
+	*UniqueItemsValidator[[]string, string],
+	UniqueItemsViolationError[[]string, string],
+	UniqueItemsViolationPrinter[[]string, string],
+] = (*UniqueItemsValidator[[]string, string])(nil)
+
+// IndexError wraps a child Validator's violation with the index of the
+// slice element it came from, so RuleSet.Validate can render a path such as
+// 'Tags[3]' instead of losing the element's position.
+type IndexError struct {
+	Index int
+	Err   error
+}
+
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("[%d] %s", e.Index, e.Err)
+}
+
+func (e *IndexError) Unwrap() error {
+	return e.Err
+}
+
+// PathFrag wraps a child violation with one path segment, either ".Name"
+// for a nested struct field or "[i]"/"[k]" for a slice or map entry.
+// RuleSet.ValidatePath builds these up as it descends into nested
+// RuleSets so the top-level Validate call can render the full dotted /
+// bracketed path in one pass.
+type PathFrag struct {
+	Seg string
+	Err error
+}
+
+func (e *PathFrag) Error() string {
+	return fmt.Sprintf("%s: %s", e.Seg, e.Err)
+}
+
+func (e *PathFrag) Unwrap() error {
+	return e.Err
+}
+
+// Each applies v to every element of a slice, aggregating per-element
+// violations via errors.Join and tagging each with its index through
+// IndexError.
+func Each[T ~[]E, E any](v Validator) *EachValidator[T, E] {
+	return &EachValidator[T, E]{v: v}
+}
+
+type EachValidator[T ~[]E, E any] struct {
+	v Validator
+}
+
+func (r *EachValidator[T, E]) Validate(v any) error {
+	a := v.(T)
+	pv, isPath := r.v.(PathValidator)
+	var errs []error
+	for i, e := range a {
+		if isPath {
+			if err := pv.ValidatePath(fmt.Sprintf("[%d]", i), e); err != nil {
+				errs = append(errs, err)
+			}
+			continue
+		}
+		if err := r.v.Validate(e); err != nil {
+			errs = append(errs, &IndexError{Index: i, Err: err})
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,64 @@
+package validator
+
+import "testing"
+
+func TestMinItems(t *testing.T) {
+	v := MinItems[[]string, string](2)
+	if err := v.Validate([]string{"a", "b"}); err != nil {
+		t.Errorf("Validate = %v, want nil", err)
+	}
+	if err := v.Validate([]string{"a"}); err == nil {
+		t.Error("Validate = nil, want a violation")
+	}
+}
+
+func TestMaxItems(t *testing.T) {
+	v := MaxItems[[]string, string](2)
+	if err := v.Validate([]string{"a", "b"}); err != nil {
+		t.Errorf("Validate = %v, want nil", err)
+	}
+	if err := v.Validate([]string{"a", "b", "c"}); err == nil {
+		t.Error("Validate = nil, want a violation")
+	}
+}
+
+func TestItems(t *testing.T) {
+	v := Items[[]string, string](1, 2)
+	if err := v.Validate([]string{"a"}); err != nil {
+		t.Errorf("Validate = %v, want nil", err)
+	}
+	if err := v.Validate([]string{}); err == nil {
+		t.Error("Validate(empty) = nil, want a min_items violation")
+	}
+	if err := v.Validate([]string{"a", "b", "c"}); err == nil {
+		t.Error("Validate(3 items) = nil, want a max_items violation")
+	}
+}
+
+func TestUniqueItems(t *testing.T) {
+	v := UniqueItems[[]string, string]()
+	if err := v.Validate([]string{"a", "b", "c"}); err != nil {
+		t.Errorf("Validate = %v, want nil", err)
+	}
+	err := v.Validate([]string{"a", "b", "a"})
+	if err == nil {
+		t.Fatal("Validate = nil, want a unique_items violation")
+	}
+	ve, ok := err.(*UniqueItemsViolationError[[]string, string])
+	if !ok {
+		t.Fatalf("err is %T, want *UniqueItemsViolationError", err)
+	}
+	if ve.Duplicate != "a" || ve.Index != 2 || ve.First != 0 {
+		t.Errorf("got Duplicate=%v Index=%d First=%d, want a/2/0", ve.Duplicate, ve.Index, ve.First)
+	}
+}
+
+func TestEach(t *testing.T) {
+	v := Each[[]string, string](MinLength[string](2))
+	if err := v.Validate([]string{"ab", "cd"}); err != nil {
+		t.Errorf("Validate = %v, want nil", err)
+	}
+	if err := v.Validate([]string{"ab", "c"}); err == nil {
+		t.Error("Validate = nil, want a violation for the second, too-short element")
+	}
+}
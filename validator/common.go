@@ -0,0 +1,62 @@
+// Package validator provides typed, composable validators for use with
+// [requiring.RuleSet.Add].
+package validator
+
+import "io"
+
+// Validator is implemented by anything that can validate a value. It
+// matches requiring.Validator structurally rather than importing it, since
+// package requiring imports this package.
+type Validator interface {
+	Validate(v any) error
+}
+
+// PathValidator is implemented by a validator that can render its own
+// violations already rooted at a dotted/bracketed path — typically a
+// nested RuleSet built via requiring.Struct. Each and EachMap check for it
+// so violations from collections of nested structs read as
+// 'Items[2].Name' instead of losing the inner field name. It matches
+// requiring.RuleSet structurally, for the same reason Validator does.
+type PathValidator interface {
+	ValidatePath(path string, v any) error
+}
+
+// Printer formats the violation produced by a validator into w.
+type Printer[T any] interface {
+	Print(w io.Writer, e *T)
+}
+
+// printerFunc adapts a plain function to a Printer.
+func printerFunc[T any](fn func(w io.Writer, e *T)) Printer[T] {
+	return printerFuncAdapter[T](fn)
+}
+
+type printerFuncAdapter[T any] func(w io.Writer, e *T)
+
+func (f printerFuncAdapter[T]) Print(w io.Writer, e *T) {
+	f(w, e)
+}
+
+// typedValidator constrains a validator V whose violation type is E and
+// whose printer type is P, tying the three together so WithPrinter always
+// returns the concrete validator type. It exists purely to be asserted
+// against at package init time (see the `var _ typedValidator[...]` lines
+// below each validator), catching signature drift between a validator, its
+// violation error and its printer at compile time.
+type typedValidator[V any, E any, P Printer[E]] interface {
+	Validate(v any) error
+	WithPrinter(p P) V
+}
+
+// Ordered is the set of types supporting the < and > operators.
+type Ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 | ~string
+}
+
+// Integer is the set of integer types, signed and unsigned.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
@@ -60,6 +60,14 @@ func (e MinLengthViolationError[T]) Error() string {
 	return w.String()
 }
 
+func (e MinLengthViolationError[T]) Code() string {
+	return "min_length"
+}
+
+func (e MinLengthViolationError[T]) Params() map[string]any {
+	return map[string]any{"min": e.Min}
+}
+
 type minLengthViolationPrinter[T ~string] struct{}
 
 func (minLengthViolationPrinter[T]) Print(w io.Writer, e *MinLengthViolationError[T]) {
@@ -130,6 +138,14 @@ func (e MaxLengthViolationError[T]) Error() string {
 	return w.String()
 }
 
+func (e MaxLengthViolationError[T]) Code() string {
+	return "max_length"
+}
+
+func (e MaxLengthViolationError[T]) Params() map[string]any {
+	return map[string]any{"max": e.Max}
+}
+
 type maxLengthViolationPrinter[T ~string] struct{}
 
 func (maxLengthViolationPrinter[T]) Print(w io.Writer, e *MaxLengthViolationError[T]) {
@@ -202,6 +218,14 @@ func (e LengthViolationError[T]) Error() string {
 	return w.String()
 }
 
+func (e LengthViolationError[T]) Code() string {
+	return "length"
+}
+
+func (e LengthViolationError[T]) Params() map[string]any {
+	return map[string]any{"min": e.Min, "max": e.Max}
+}
+
 type lengthViolationPrinter[T ~string] struct{}
 
 func (lengthViolationPrinter[T]) Print(w io.Writer, e *LengthViolationError[T]) {
@@ -0,0 +1,36 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+)
+
+// EachMap applies v to every value of a map, aggregating per-entry
+// violations via errors.Join and tagging each with its key, e.g.
+// 'Meta[admin].Name' when v is itself a nested RuleSet.
+func EachMap[M ~map[K]V, K comparable, V any](v Validator) *EachMapValidator[M, K, V] {
+	return &EachMapValidator[M, K, V]{v: v}
+}
+
+type EachMapValidator[M ~map[K]V, K comparable, V any] struct {
+	v Validator
+}
+
+func (r *EachMapValidator[M, K, V]) Validate(v any) error {
+	m := v.(M)
+	pv, isPath := r.v.(PathValidator)
+	var errs []error
+	for k, e := range m {
+		seg := fmt.Sprintf("[%v]", k)
+		if isPath {
+			if err := pv.ValidatePath(seg, e); err != nil {
+				errs = append(errs, err)
+			}
+			continue
+		}
+		if err := r.v.Validate(e); err != nil {
+			errs = append(errs, &PathFrag{Seg: seg, Err: err})
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,471 @@
+package validator
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+)
+
+func Min[T Ordered](n T) *MinValidator[T] {
+	var r MinValidator[T]
+	r.min = n
+	return &r
+}
+
+type MinValidator[T Ordered] struct {
+	min T
+	p   MinViolationPrinter[T]
+}
+
+func (r *MinValidator[T]) WithPrinter(p MinViolationPrinter[T]) *MinValidator[T] {
+	rr := *r
+	rr.p = p
+	return &rr
+}
+
+func (r *MinValidator[T]) WithPrinterFunc(fn func(w io.Writer, min T)) *MinValidator[T] {
+	rr := *r
+	rr.p = printerFunc(func(w io.Writer, e *MinViolationError[T]) {
+		fn(w, e.Min)
+	})
+	return &rr
+}
+
+func (r *MinValidator[T]) Validate(v any) error {
+	n := v.(T)
+	if n < r.min {
+		return &MinViolationError[T]{
+			Value: n,
+			Min:   r.min,
+			rule:  r,
+		}
+	}
+	return nil
+}
+
+type MinViolationError[T Ordered] struct {
+	Value T
+	Min   T
+	rule  *MinValidator[T]
+}
+
+func (e MinViolationError[T]) Error() string {
+	p := e.rule.p
+	if p == nil {
+		p = &minViolationPrinter[T]{}
+	}
+	var w bytes.Buffer
+	p.Print(&w, &e)
+	return w.String()
+}
+
+func (e MinViolationError[T]) Code() string {
+	return "min"
+}
+
+func (e MinViolationError[T]) Params() map[string]any {
+	return map[string]any{"min": e.Min}
+}
+
+type minViolationPrinter[T Ordered] struct{}
+
+func (minViolationPrinter[T]) Print(w io.Writer, e *MinViolationError[T]) {
+	fmt.Fprintf(w, "must be no less than %v", e.Min)
+}
+
+type MinViolationPrinter[T Ordered] interface {
+	Printer[MinViolationError[T]]
+}
+
+var _ typedValidator[
+	*MinValidator[int],
+	MinViolationError[int],
+	MinViolationPrinter[int],
+] = (*MinValidator[int])(nil)
+
+func Max[T Ordered](n T) *MaxValidator[T] {
+	var r MaxValidator[T]
+	r.max = n
+	return &r
+}
+
+type MaxValidator[T Ordered] struct {
+	max T
+	p   MaxViolationPrinter[T]
+}
+
+func (r *MaxValidator[T]) WithPrinter(p MaxViolationPrinter[T]) *MaxValidator[T] {
+	rr := *r
+	rr.p = p
+	return &rr
+}
+
+func (r *MaxValidator[T]) WithPrinterFunc(fn func(w io.Writer, max T)) *MaxValidator[T] {
+	rr := *r
+	rr.p = printerFunc(func(w io.Writer, e *MaxViolationError[T]) {
+		fn(w, e.Max)
+	})
+	return &rr
+}
+
+func (r *MaxValidator[T]) Validate(v any) error {
+	n := v.(T)
+	if n > r.max {
+		return &MaxViolationError[T]{
+			Value: n,
+			Max:   r.max,
+			rule:  r,
+		}
+	}
+	return nil
+}
+
+type MaxViolationError[T Ordered] struct {
+	Value T
+	Max   T
+	rule  *MaxValidator[T]
+}
+
+func (e MaxViolationError[T]) Error() string {
+	p := e.rule.p
+	if p == nil {
+		p = &maxViolationPrinter[T]{}
+	}
+	var w bytes.Buffer
+	p.Print(&w, &e)
+	return w.String()
+}
+
+func (e MaxViolationError[T]) Code() string {
+	return "max"
+}
+
+func (e MaxViolationError[T]) Params() map[string]any {
+	return map[string]any{"max": e.Max}
+}
+
+type maxViolationPrinter[T Ordered] struct{}
+
+func (maxViolationPrinter[T]) Print(w io.Writer, e *MaxViolationError[T]) {
+	fmt.Fprintf(w, "must be no greater than %v", e.Max)
+}
+
+type MaxViolationPrinter[T Ordered] interface {
+	Printer[MaxViolationError[T]]
+}
+
+var _ typedValidator[
+	*MaxValidator[int],
+	MaxViolationError[int],
+	MaxViolationPrinter[int],
+] = (*MaxValidator[int])(nil)
+
+func Range[T Ordered](min, max T) *RangeValidator[T] {
+	var r RangeValidator[T]
+	r.min = min
+	r.max = max
+	return &r
+}
+
+type RangeValidator[T Ordered] struct {
+	min, max T
+	p        RangeViolationPrinter[T]
+}
+
+func (r *RangeValidator[T]) WithPrinter(p RangeViolationPrinter[T]) *RangeValidator[T] {
+	rr := *r
+	rr.p = p
+	return &rr
+}
+
+func (r *RangeValidator[T]) WithPrinterFunc(fn func(w io.Writer, min, max T)) *RangeValidator[T] {
+	rr := *r
+	rr.p = printerFunc(func(w io.Writer, e *RangeViolationError[T]) {
+		fn(w, e.Min, e.Max)
+	})
+	return &rr
+}
+
+func (r *RangeValidator[T]) Validate(v any) error {
+	n := v.(T)
+	if n < r.min || n > r.max {
+		return &RangeViolationError[T]{
+			Value: n,
+			Min:   r.min,
+			Max:   r.max,
+			rule:  r,
+		}
+	}
+	return nil
+}
+
+type RangeViolationError[T Ordered] struct {
+	Value    T
+	Min, Max T
+	rule     *RangeValidator[T]
+}
+
+func (e RangeViolationError[T]) Error() string {
+	p := e.rule.p
+	if p == nil {
+		p = &rangeViolationPrinter[T]{}
+	}
+	var w bytes.Buffer
+	p.Print(&w, &e)
+	return w.String()
+}
+
+func (e RangeViolationError[T]) Code() string {
+	return "range"
+}
+
+func (e RangeViolationError[T]) Params() map[string]any {
+	return map[string]any{"min": e.Min, "max": e.Max}
+}
+
+type rangeViolationPrinter[T Ordered] struct{}
+
+func (rangeViolationPrinter[T]) Print(w io.Writer, e *RangeViolationError[T]) {
+	fmt.Fprintf(w, "must be in range(%v ... %v)", e.Min, e.Max)
+}
+
+type RangeViolationPrinter[T Ordered] interface {
+	Printer[RangeViolationError[T]]
+}
+
+var _ typedValidator[
+	*RangeValidator[int],
+	RangeViolationError[int],
+	RangeViolationPrinter[int],
+] = (*RangeValidator[int])(nil)
+
+func MultipleOf[T Integer](n T) *MultipleOfValidator[T] {
+	var r MultipleOfValidator[T]
+	r.n = n
+	return &r
+}
+
+type MultipleOfValidator[T Integer] struct {
+	n T
+	p MultipleOfViolationPrinter[T]
+}
+
+func (r *MultipleOfValidator[T]) WithPrinter(p MultipleOfViolationPrinter[T]) *MultipleOfValidator[T] {
+	rr := *r
+	rr.p = p
+	return &rr
+}
+
+func (r *MultipleOfValidator[T]) WithPrinterFunc(fn func(w io.Writer, n T)) *MultipleOfValidator[T] {
+	rr := *r
+	rr.p = printerFunc(func(w io.Writer, e *MultipleOfViolationError[T]) {
+		fn(w, e.N)
+	})
+	return &rr
+}
+
+func (r *MultipleOfValidator[T]) Validate(v any) error {
+	n := v.(T)
+	if r.n != 0 && n%r.n != 0 {
+		return &MultipleOfViolationError[T]{
+			Value: n,
+			N:     r.n,
+			rule:  r,
+		}
+	}
+	return nil
+}
+
+type MultipleOfViolationError[T Integer] struct {
+	Value T
+	N     T
+	rule  *MultipleOfValidator[T]
+}
+
+func (e MultipleOfViolationError[T]) Error() string {
+	p := e.rule.p
+	if p == nil {
+		p = &multipleOfViolationPrinter[T]{}
+	}
+	var w bytes.Buffer
+	p.Print(&w, &e)
+	return w.String()
+}
+
+func (e MultipleOfViolationError[T]) Code() string {
+	return "multiple_of"
+}
+
+func (e MultipleOfViolationError[T]) Params() map[string]any {
+	return map[string]any{"n": e.N}
+}
+
+type multipleOfViolationPrinter[T Integer] struct{}
+
+func (multipleOfViolationPrinter[T]) Print(w io.Writer, e *MultipleOfViolationError[T]) {
+	fmt.Fprintf(w, "must be a multiple of %v", e.N)
+}
+
+type MultipleOfViolationPrinter[T Integer] interface {
+	Printer[MultipleOfViolationError[T]]
+}
+
+var _ typedValidator[
+	*MultipleOfValidator[int],
+	MultipleOfViolationError[int],
+	MultipleOfViolationPrinter[int],
+] = (*MultipleOfValidator[int])(nil)
+
+func GreaterThan[T Ordered](n T) *GreaterThanValidator[T] {
+	var r GreaterThanValidator[T]
+	r.n = n
+	return &r
+}
+
+type GreaterThanValidator[T Ordered] struct {
+	n T
+	p GreaterThanViolationPrinter[T]
+}
+
+func (r *GreaterThanValidator[T]) WithPrinter(p GreaterThanViolationPrinter[T]) *GreaterThanValidator[T] {
+	rr := *r
+	rr.p = p
+	return &rr
+}
+
+func (r *GreaterThanValidator[T]) WithPrinterFunc(fn func(w io.Writer, n T)) *GreaterThanValidator[T] {
+	rr := *r
+	rr.p = printerFunc(func(w io.Writer, e *GreaterThanViolationError[T]) {
+		fn(w, e.N)
+	})
+	return &rr
+}
+
+func (r *GreaterThanValidator[T]) Validate(v any) error {
+	n := v.(T)
+	if n <= r.n {
+		return &GreaterThanViolationError[T]{
+			Value: n,
+			N:     r.n,
+			rule:  r,
+		}
+	}
+	return nil
+}
+
+type GreaterThanViolationError[T Ordered] struct {
+	Value T
+	N     T
+	rule  *GreaterThanValidator[T]
+}
+
+func (e GreaterThanViolationError[T]) Error() string {
+	p := e.rule.p
+	if p == nil {
+		p = &greaterThanViolationPrinter[T]{}
+	}
+	var w bytes.Buffer
+	p.Print(&w, &e)
+	return w.String()
+}
+
+func (e GreaterThanViolationError[T]) Code() string {
+	return "gt"
+}
+
+func (e GreaterThanViolationError[T]) Params() map[string]any {
+	return map[string]any{"n": e.N}
+}
+
+type greaterThanViolationPrinter[T Ordered] struct{}
+
+func (greaterThanViolationPrinter[T]) Print(w io.Writer, e *GreaterThanViolationError[T]) {
+	fmt.Fprintf(w, "must be greater than %v", e.N)
+}
+
+type GreaterThanViolationPrinter[T Ordered] interface {
+	Printer[GreaterThanViolationError[T]]
+}
+
+var _ typedValidator[
+	*GreaterThanValidator[int],
+	GreaterThanViolationError[int],
+	GreaterThanViolationPrinter[int],
+] = (*GreaterThanValidator[int])(nil)
+
+func LessThan[T Ordered](n T) *LessThanValidator[T] {
+	var r LessThanValidator[T]
+	r.n = n
+	return &r
+}
+
+type LessThanValidator[T Ordered] struct {
+	n T
+	p LessThanViolationPrinter[T]
+}
+
+func (r *LessThanValidator[T]) WithPrinter(p LessThanViolationPrinter[T]) *LessThanValidator[T] {
+	rr := *r
+	rr.p = p
+	return &rr
+}
+
+func (r *LessThanValidator[T]) WithPrinterFunc(fn func(w io.Writer, n T)) *LessThanValidator[T] {
+	rr := *r
+	rr.p = printerFunc(func(w io.Writer, e *LessThanViolationError[T]) {
+		fn(w, e.N)
+	})
+	return &rr
+}
+
+func (r *LessThanValidator[T]) Validate(v any) error {
+	n := v.(T)
+	if n >= r.n {
+		return &LessThanViolationError[T]{
+			Value: n,
+			N:     r.n,
+			rule:  r,
+		}
+	}
+	return nil
+}
+
+type LessThanViolationError[T Ordered] struct {
+	Value T
+	N     T
+	rule  *LessThanValidator[T]
+}
+
+func (e LessThanViolationError[T]) Error() string {
+	p := e.rule.p
+	if p == nil {
+		p = &lessThanViolationPrinter[T]{}
+	}
+	var w bytes.Buffer
+	p.Print(&w, &e)
+	return w.String()
+}
+
+func (e LessThanViolationError[T]) Code() string {
+	return "lt"
+}
+
+func (e LessThanViolationError[T]) Params() map[string]any {
+	return map[string]any{"n": e.N}
+}
+
+type lessThanViolationPrinter[T Ordered] struct{}
+
+func (lessThanViolationPrinter[T]) Print(w io.Writer, e *LessThanViolationError[T]) {
+	fmt.Fprintf(w, "must be less than %v", e.N)
+}
+
+type LessThanViolationPrinter[T Ordered] interface {
+	Printer[LessThanViolationError[T]]
+}
+
+var _ typedValidator[
+	*LessThanValidator[int],
+	LessThanViolationError[int],
+	LessThanViolationPrinter[int],
+] = (*LessThanValidator[int])(nil)
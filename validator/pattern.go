@@ -0,0 +1,101 @@
+package validator
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"regexp"
+)
+
+func Pattern[T ~string](re *regexp.Regexp) *PatternValidator[T] {
+	var r PatternValidator[T]
+	r.re = re
+	return &r
+}
+
+// MustPattern compiles expr and panics if it is not a valid regular
+// expression, mirroring regexp.MustCompile.
+func MustPattern[T ~string](expr string) *PatternValidator[T] {
+	return Pattern[T](regexp.MustCompile(expr))
+}
+
+type PatternValidator[T ~string] struct {
+	re *regexp.Regexp
+	p  PatternViolationPrinter[T]
+}
+
+func (r *PatternValidator[T]) WithPrinter(p PatternViolationPrinter[T]) *PatternValidator[T] {
+	rr := *r
+	rr.p = p
+	return &rr
+}
+
+func (r *PatternValidator[T]) WithPrinterFunc(fn func(w io.Writer, re *regexp.Regexp)) *PatternValidator[T] {
+	rr := *r
+	rr.p = printerFunc(func(w io.Writer, e *PatternViolationError[T]) {
+		fn(w, e.Pattern)
+	})
+	return &rr
+}
+
+func (r *PatternValidator[T]) Validate(v any) error {
+	s := v.(T)
+	if !r.re.MatchString(string(s)) {
+		return &PatternViolationError[T]{
+			Value:   s,
+			Pattern: r.re,
+			rule:    r,
+		}
+	}
+	return nil
+}
+
+type PatternViolationError[T ~string] struct {
+	Value   T
+	Pattern *regexp.Regexp
+	rule    *PatternValidator[T]
+}
+
+func (e PatternViolationError[T]) Error() string {
+	p := e.rule.p
+	if p == nil {
+		p = &patternViolationPrinter[T]{}
+	}
+	var w bytes.Buffer
+	p.Print(&w, &e)
+	return w.String()
+}
+
+func (e PatternViolationError[T]) Code() string {
+	return "pattern"
+}
+
+func (e PatternViolationError[T]) Params() map[string]any {
+	return map[string]any{"pattern": e.Pattern.String()}
+}
+
+type patternViolationPrinter[T ~string] struct{}
+
+func (patternViolationPrinter[T]) Print(w io.Writer, e *PatternViolationError[T]) {
+	fmt.Fprintf(w, "must match pattern %s", e.Pattern)
+}
+
+type PatternViolationPrinter[T ~string] interface {
+	Printer[PatternViolationError[T]]
+}
+
+var _ typedValidator[
+	*PatternValidator[string],
+	PatternViolationError[string],
+	PatternViolationPrinter[string],
+] = (*PatternValidator[string])(nil)
+
+// Predefined patterns for common formats.
+var (
+	Email        = MustPattern[string](`^[^\s@]+@[^\s@]+\.[^\s@]+$`)
+	URL          = MustPattern[string](`^[a-zA-Z][a-zA-Z0-9+.-]*://\S+$`)
+	UUID         = MustPattern[string](`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	Alphanumeric = MustPattern[string](`^[a-zA-Z0-9]+$`)
+	ASCII        = MustPattern[string](`^[\x00-\x7F]+$`)
+	E164         = MustPattern[string](`^\+[1-9]\d{1,14}$`)
+)
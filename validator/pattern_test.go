@@ -0,0 +1,48 @@
+package validator
+
+import "testing"
+
+func TestPattern(t *testing.T) {
+	v := MustPattern[string](`^[a-z]+$`)
+	if err := v.Validate("abc"); err != nil {
+		t.Errorf("Validate(%q) = %v, want nil", "abc", err)
+	}
+	if err := v.Validate("ABC"); err == nil {
+		t.Error("Validate(\"ABC\") = nil, want a violation")
+	}
+}
+
+func TestMustPattern_PanicsOnInvalidExpr(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("MustPattern did not panic on an invalid expression")
+		}
+	}()
+	MustPattern[string](`[`)
+}
+
+func TestPredefinedPatterns(t *testing.T) {
+	tests := []struct {
+		name  string
+		v     *PatternValidator[string]
+		valid string
+		bad   string
+	}{
+		{"Email", Email, "user@example.com", "not-an-email"},
+		{"URL", URL, "https://example.com/path", "not a url"},
+		{"UUID", UUID, "123e4567-e89b-12d3-a456-426614174000", "not-a-uuid"},
+		{"Alphanumeric", Alphanumeric, "abc123", "abc-123"},
+		{"ASCII", ASCII, "hello", "héllo"},
+		{"E164", E164, "+15551234567", "5551234567"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.v.Validate(tt.valid); err != nil {
+				t.Errorf("Validate(%q) = %v, want nil", tt.valid, err)
+			}
+			if err := tt.v.Validate(tt.bad); err == nil {
+				t.Errorf("Validate(%q) = nil, want a violation", tt.bad)
+			}
+		})
+	}
+}
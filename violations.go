@@ -0,0 +1,120 @@
+package requiring
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// CodedError is implemented by every *ViolationError type in this module,
+// giving Violations a machine-readable Code and Params to go with the
+// human-readable message from Error(). Third-party validators can
+// implement it too.
+type CodedError interface {
+	error
+	Code() string
+	Params() map[string]any
+}
+
+// fieldError pairs a violation with the dotted/bracketed field path it was
+// found at. renderPath builds these instead of formatting the message
+// directly, so Violations can recover the field without re-parsing it out
+// of Error()'s text.
+type fieldError struct {
+	Field string
+	Err   error
+}
+
+func (e *fieldError) Error() string {
+	return fmt.Sprintf("'%s' %s", e.Field, e.Err)
+}
+
+func (e *fieldError) Unwrap() error {
+	return e.Err
+}
+
+// Violation is the machine-readable form of a single field violation.
+type Violation struct {
+	Field   string
+	Code    string
+	Params  map[string]any
+	Message string
+}
+
+func (v Violation) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Field   string         `json:"field"`
+		Code    string         `json:"code,omitempty"`
+		Params  map[string]any `json:"params,omitempty"`
+		Message string         `json:"message"`
+	}{
+		Field:   v.Field,
+		Code:    v.Code,
+		Params:  v.Params,
+		Message: v.Message,
+	})
+}
+
+// Violations flattens err, as returned by RuleSet.Validate (or any tree
+// built from errors.Join and fieldError), into one Violation per leaf
+// violation, sorted by field so repeated calls against the same error
+// produce a stable result regardless of RuleSet's map-backed rule order.
+func Violations(err error) []Violation {
+	var out []Violation
+	collectViolations("", err, &out)
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Field < out[j].Field
+	})
+	return out
+}
+
+func collectViolations(field string, err error, out *[]Violation) {
+	if err == nil {
+		return
+	}
+	if j, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, e := range j.Unwrap() {
+			collectViolations(field, e, out)
+		}
+		return
+	}
+	if fe, ok := err.(*fieldError); ok {
+		collectViolations(fe.Field, fe.Err, out)
+		return
+	}
+	v := Violation{Field: field, Message: err.Error()}
+	if ce, ok := err.(CodedError); ok {
+		v.Code = ce.Code()
+		v.Params = ce.Params()
+	}
+	*out = append(*out, v)
+}
+
+// Problem is an RFC 7807 application/problem+json document, with the
+// field-level Violations carried as its "errors" extension member, the
+// convention most HTTP frameworks use for per-field validation errors.
+type Problem struct {
+	Type       string      `json:"type,omitempty"`
+	Title      string      `json:"title"`
+	Status     int         `json:"status,omitempty"`
+	Detail     string      `json:"detail,omitempty"`
+	Violations []Violation `json:"errors"`
+}
+
+// NewProblem builds a Problem from a RuleSet.Validate error and an HTTP
+// status, typically http.StatusUnprocessableEntity.
+func NewProblem(err error, status int) *Problem {
+	return &Problem{
+		Title:      "Validation Failed",
+		Status:     status,
+		Violations: Violations(err),
+	}
+}
+
+// WriteProblem encodes err as an RFC 7807 problem+json document to w.
+// Callers are responsible for setting the response's Content-Type to
+// "application/problem+json" and status code.
+func WriteProblem(w io.Writer, err error, status int) error {
+	return json.NewEncoder(w).Encode(NewProblem(err, status))
+}
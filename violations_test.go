@@ -0,0 +1,43 @@
+package requiring
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/lufia/go-requiring/validator"
+)
+
+type multiFieldPerson struct {
+	Name string
+	Age  int
+	Tags []string
+}
+
+// Regression test: s.rules is a plain map, so the order RuleSet.Validate
+// walked rules in (and thus the order fieldError nodes were joined in) used
+// to vary from run to run. Violations now sorts its output by field, so the
+// returned slice is stable regardless of that map iteration order.
+func TestViolations_StableFieldOrder(t *testing.T) {
+	s := Struct(func(s *RuleSet, v *multiFieldPerson) {
+		s.Add(&v.Name, "Name", validator.MinLength[string](3))
+		s.Add(&v.Age, "Age", validator.Min[int](18))
+		s.Add(&v.Tags, "Tags", validator.MinItems[[]string, string](1))
+	})
+
+	err := s.Validate(&multiFieldPerson{Name: "a", Age: 1, Tags: nil})
+	if err == nil {
+		t.Fatal("expected violations for all three fields")
+	}
+
+	want := []string{"Age", "Name", "Tags"}
+	for i := 0; i < 20; i++ {
+		vs := Violations(err)
+		got := make([]string, len(vs))
+		for j, v := range vs {
+			got[j] = v.Field
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("Violations() fields = %v, want %v (sorted)", got, want)
+		}
+	}
+}